@@ -0,0 +1,287 @@
+// Package bitbucket is the Bitbucket Server (Data Center) implementation of
+// the forge.Provider interface, backed by gfleury/go-bitbucket-v1.
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+
+	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
+)
+
+// Bitbucket is a thin wrapper around the go-bitbucket-v1 client, mirroring
+// the shape of the GitHub and GitLab clients in this module.
+//
+// Several operations below (branch creation, file edits, branch
+// permissions) are not fully wired up by the generated DefaultApiService
+// methods in this version of go-bitbucket-v1 — they build their request but
+// never populate a body. Those are implemented here as raw HTTP requests
+// against the documented Bitbucket Server REST endpoints instead, using
+// cfg/token directly.
+type Bitbucket struct {
+	Logger *slog.Logger
+	Client *bitbucketv1.APIClient
+	cfg    *bitbucketv1.Configuration
+	token  string
+}
+
+// New creates a new instance of the Bitbucket Server client with the
+// provided logger, authentication token, and server base URL (e.g.
+// https://bitbucket.example.com/rest).
+func New(logger *slog.Logger, token, baseURL string) *Bitbucket {
+	cfg := bitbucketv1.NewConfiguration(baseURL)
+	ctx := context.WithValue(context.Background(), bitbucketv1.ContextAccessToken, token)
+	client := bitbucketv1.NewAPIClient(ctx, cfg)
+
+	return &Bitbucket{
+		Logger: logger.With("common", "bitbucket"),
+		Client: client,
+		cfg:    cfg,
+		token:  token,
+	}
+}
+
+// GetRepository gets a repository as specified by the project key/name parameters.
+func (bb *Bitbucket) GetRepository(ctx context.Context, projectKey, name string) (*bitbucketv1.Repository, error) {
+	bb.Logger.Debug("get repository", slog.String("project key", projectKey), slog.String("repo name", name))
+	res, err := bb.Client.DefaultApi.GetRepository(projectKey, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository %s/%s: %w", projectKey, name, err)
+	}
+
+	repo, err := bitbucketv1.GetRepositoryResponse(res)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing repository response %s/%s: %w", projectKey, name, err)
+	}
+
+	return &repo, nil
+}
+
+// CreateRepository creates a new repository under the given project key.
+func (bb *Bitbucket) CreateRepository(ctx context.Context, projectKey, name string, public bool) (*bitbucketv1.Repository, error) {
+	bb.Logger.Debug("creating repository", slog.String("project key", projectKey), slog.String("name", name))
+	res, err := bb.Client.DefaultApi.CreateRepository(projectKey, bitbucketv1.Repository{
+		Name:   name,
+		Public: public,
+	})
+	if err != nil {
+		bb.Logger.Debug("error creating repository", slog.String("project key", projectKey), slog.String("name", name))
+		return nil, fmt.Errorf("error creating repository %s/%s: %w", projectKey, name, err)
+	}
+
+	repo, err := bitbucketv1.GetRepositoryResponse(res)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing repository response %s/%s: %w", projectKey, name, err)
+	}
+
+	return &repo, nil
+}
+
+// CreateBranch creates a new branch in the specified repository, starting
+// from the repository's default branch.
+//
+// DefaultApiService.CreateBranch never populates a request body, so it
+// cannot actually specify a branch name or start point; this issues the
+// documented POST directly instead.
+func (bb *Bitbucket) CreateBranch(ctx context.Context, projectKey, repoName, branchName string) error {
+	bb.Logger.Debug("creating branch", slog.String("project key", projectKey), slog.String("repo name", repoName), slog.String("branch name", branchName))
+	defaultBranch, err := bb.Client.DefaultApi.GetDefaultBranch(projectKey, repoName)
+	if err != nil {
+		return fmt.Errorf("error getting default branch: %w", err)
+	}
+
+	branch, err := bitbucketv1.GetBranchResponse(defaultBranch)
+	if err != nil {
+		return fmt.Errorf("error parsing default branch response: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"name":       branchName,
+		"startPoint": branch.LatestCommit,
+	})
+	if err != nil {
+		return fmt.Errorf("error building create branch request: %w", err)
+	}
+
+	path := fmt.Sprintf("/api/1.0/projects/%s/repos/%s/branches", projectKey, repoName)
+	if _, err := bb.do(ctx, http.MethodPost, path, "application/json", body); err != nil {
+		bb.Logger.Debug("error creating new branch", slog.String("project key", projectKey), slog.String("repo name", repoName), slog.String("new branch name", branchName))
+		return fmt.Errorf("error creating branch %s: %w", branchName, err)
+	}
+
+	return nil
+}
+
+// CreateOrUpdateFile creates or updates a file in the specified repository and branch.
+//
+// DefaultApiService.EditFile never populates its multipart request body
+// despite documenting the required form fields, so this builds and sends
+// that multipart PUT directly instead.
+func (bb *Bitbucket) CreateOrUpdateFile(ctx context.Context, projectKey, repoName, branch, commitMessage, filePath, replacingFileSHA string, fileContent []byte) error {
+	bb.Logger.Debug("creating file", slog.String("repo name", repoName), slog.String("branch name", branch), slog.String("file path", filePath))
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	if err := form.WriteField("branch", branch); err != nil {
+		return fmt.Errorf("error building file edit request: %w", err)
+	}
+	if err := form.WriteField("message", commitMessage); err != nil {
+		return fmt.Errorf("error building file edit request: %w", err)
+	}
+	if replacingFileSHA != "" {
+		if err := form.WriteField("sourceCommitId", replacingFileSHA); err != nil {
+			return fmt.Errorf("error building file edit request: %w", err)
+		}
+	}
+	contentField, err := form.CreateFormField("content")
+	if err != nil {
+		return fmt.Errorf("error building file edit request: %w", err)
+	}
+	if _, err := contentField.Write(fileContent); err != nil {
+		return fmt.Errorf("error building file edit request: %w", err)
+	}
+	if err := form.Close(); err != nil {
+		return fmt.Errorf("error building file edit request: %w", err)
+	}
+
+	path := fmt.Sprintf("/api/1.0/projects/%s/repos/%s/browse/%s", projectKey, repoName, filePath)
+	if _, err := bb.do(ctx, http.MethodPut, path, form.FormDataContentType(), buf.Bytes()); err != nil {
+		bb.Logger.Debug("error creating file", slog.String("repo name", repoName), slog.String("branch name", branch), slog.String("file path", filePath))
+		return fmt.Errorf("error creating file %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+// GetRepositoryContent gets the raw content of a file in a repository.
+func (bb *Bitbucket) GetRepositoryContent(ctx context.Context, projectKey, repoName, path, ref string) ([]byte, error) {
+	bb.Logger.Debug("getting repository content", slog.String("repo name", repoName), slog.String("ref", ref), slog.String("path", path))
+	res, err := bb.Client.DefaultApi.GetRawContent(projectKey, repoName, path, map[string]interface{}{"at": ref})
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository content %s: %w", path, err)
+	}
+
+	return res.Payload, nil
+}
+
+// ReplaceTopics is not supported by Bitbucket Server, which has no concept
+// of repository topics; it is a no-op that returns the input unchanged.
+func (bb *Bitbucket) ReplaceTopics(ctx context.Context, projectKey, repoName string, topics []string) ([]string, error) {
+	bb.Logger.Debug("topics are not supported on bitbucket server", slog.String("repo name", repoName))
+	return topics, nil
+}
+
+// CreateOrUpdateRepositorySecret is not supported: stock Bitbucket Server has
+// no equivalent of GitHub Actions secrets or GitLab CI variables, and
+// go-bitbucket-v1 wraps no such endpoint. Unlike ReplaceTopics, silently
+// succeeding here would be misleading, so this returns an explicit error.
+func (bb *Bitbucket) CreateOrUpdateRepositorySecret(ctx context.Context, projectKey, repoName, secretName, secretValue string) error {
+	bb.Logger.Debug("repository secrets are not supported on bitbucket server", slog.String("repo name", repoName), slog.String("secret name", secretName))
+	return fmt.Errorf("creating repository secret %s: not supported on bitbucket server", secretName)
+}
+
+// CreatePullRequest creates a pull request in the specified repository.
+func (bb *Bitbucket) CreatePullRequest(ctx context.Context, projectKey, repoName, title, body, head, base string) (*bitbucketv1.PullRequest, error) {
+	bb.Logger.Debug("creating pull request", slog.String("repo name", repoName), slog.String("title", title), slog.String("head", head), slog.String("base", base))
+	res, err := bb.Client.DefaultApi.CreatePullRequest(projectKey, repoName, bitbucketv1.PullRequest{
+		Title:       title,
+		Description: body,
+		FromRef: bitbucketv1.PullRequestRef{
+			ID: "refs/heads/" + head,
+		},
+		ToRef: bitbucketv1.PullRequestRef{
+			ID: "refs/heads/" + base,
+		},
+	})
+	if err != nil {
+		bb.Logger.Debug("error creating pull request", slog.String("repo name", repoName), slog.String("title", title), slog.String("head", head), slog.String("base", base))
+		return nil, fmt.Errorf("error creating pull request: %w", err)
+	}
+
+	pr, err := bitbucketv1.GetPullRequestResponse(res)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response: %w", err)
+	}
+
+	return &pr, nil
+}
+
+// CreateRepositoryRuleset configures a branch permission restricting pushes
+// to the default branch, the closest Bitbucket Server equivalent to a
+// GitHub ruleset.
+//
+// Branch permissions live under Bitbucket Server's separate
+// branch-permissions REST API group, which go-bitbucket-v1 does not wrap at
+// all, so this issues the documented request directly.
+func (bb *Bitbucket) CreateRepositoryRuleset(ctx context.Context, projectKey, repoName, rulesetName string) error {
+	bb.Logger.Debug("creating branch permission", slog.String("repo name", repoName))
+
+	defaultBranch, err := bb.Client.DefaultApi.GetDefaultBranch(projectKey, repoName)
+	if err != nil {
+		return fmt.Errorf("error getting default branch: %w", err)
+	}
+	branch, err := bitbucketv1.GetBranchResponse(defaultBranch)
+	if err != nil {
+		return fmt.Errorf("error parsing default branch response: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"type": "fast-forward-only",
+		"matcher": map[string]any{
+			"id":        "refs/heads/" + branch.DisplayID,
+			"displayId": branch.DisplayID,
+			"type": map[string]any{
+				"id":   "BRANCH",
+				"name": "Branch",
+			},
+			"active": true,
+		},
+		"users":  []string{},
+		"groups": []string{},
+	})
+	if err != nil {
+		return fmt.Errorf("error building branch permission request: %w", err)
+	}
+
+	path := fmt.Sprintf("/branch-permissions/2.0/projects/%s/repos/%s/restrictions", projectKey, repoName)
+	if _, err := bb.do(ctx, http.MethodPost, path, "application/json", body); err != nil {
+		bb.Logger.Debug("error creating branch permission", slog.String("repo name", repoName))
+		return fmt.Errorf("error creating branch permission: %w", err)
+	}
+
+	return nil
+}
+
+// do issues a raw HTTP request against bb's Bitbucket Server instance,
+// bypassing the generated client for endpoints it wraps incompletely. path
+// is joined with bb.cfg.BasePath (or, for the branch-permissions API, which
+// lives outside /rest/api, with the host derived from it).
+func (bb *Bitbucket) do(ctx context.Context, method, path, contentType string, body []byte) (*http.Response, error) {
+	url := bb.cfg.BasePath + path
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error building request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+bb.token)
+
+	res, err := bb.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("unexpected status %s: %s", res.Status, respBody)
+	}
+
+	return res, nil
+}
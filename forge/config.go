@@ -0,0 +1,73 @@
+package forge
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+)
+
+// Kind identifies which Git forge backend a Config targets.
+type Kind string
+
+const (
+	KindGitHub    Kind = "github"
+	KindGitLab    Kind = "gitlab"
+	KindBitbucket Kind = "bitbucket"
+)
+
+// Config describes how to reach and authenticate against a Git forge
+// instance.
+type Config struct {
+	// Kind selects the backend explicitly. If empty, it is inferred from
+	// BaseURL.
+	Kind Kind
+	// BaseURL is the API base URL of the forge instance. Leave empty to use
+	// the public SaaS endpoint for the selected Kind.
+	BaseURL string
+	Token   string
+	Logger  *slog.Logger
+}
+
+// New constructs the Provider implementation selected by cfg.Kind, falling
+// back to inferring the backend from cfg.BaseURL when Kind is empty.
+func New(cfg Config) (Provider, error) {
+	kind := cfg.Kind
+	if kind == "" {
+		kind = kindFromURL(cfg.BaseURL)
+	}
+
+	switch kind {
+	case KindGitHub:
+		return newGithubProvider(cfg.Logger, cfg.Token, cfg.BaseURL)
+	case KindGitLab:
+		return newGitlabProvider(cfg.Logger, cfg.Token, cfg.BaseURL)
+	case KindBitbucket:
+		return newBitbucketProvider(cfg.Logger, cfg.Token, cfg.BaseURL)
+	default:
+		return nil, fmt.Errorf("forge: unsupported provider kind %q", kind)
+	}
+}
+
+// kindFromURL guesses the forge Kind from the hostname of a base URL,
+// defaulting to GitHub when the URL is empty or unrecognized.
+func kindFromURL(rawURL string) Kind {
+	if rawURL == "" {
+		return KindGitHub
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return KindGitHub
+	}
+
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case strings.Contains(host, "gitlab"):
+		return KindGitLab
+	case strings.Contains(host, "bitbucket"):
+		return KindBitbucket
+	default:
+		return KindGitHub
+	}
+}
@@ -0,0 +1,71 @@
+// Package forge provides a Git-forge-agnostic abstraction over GitHub,
+// GitLab, and Bitbucket Server, so callers can target different providers
+// without rewriting their integration code.
+package forge
+
+import "context"
+
+// Visibility controls whether a repository is publicly readable, fully
+// private, or (on GHES/GHAE-style instances) internal to the enterprise.
+type Visibility string
+
+const (
+	VisibilityPublic   Visibility = "public"
+	VisibilityPrivate  Visibility = "private"
+	VisibilityInternal Visibility = "internal"
+)
+
+// Repository is a forge-agnostic representation of a remote repository.
+type Repository struct {
+	Owner         string
+	Name          string
+	FullName      string
+	DefaultBranch string
+	Visibility    Visibility
+	HTMLURL       string
+}
+
+// Reference is a forge-agnostic representation of a Git reference, such as a
+// branch or the commit created by a file change.
+type Reference struct {
+	Name string
+	SHA  string
+}
+
+// PullRequest is a forge-agnostic representation of a merge/pull request.
+type PullRequest struct {
+	Number  int
+	Title   string
+	Body    string
+	Head    string
+	Base    string
+	HTMLURL string
+}
+
+// RepoOptions controls repository creation across providers.
+type RepoOptions struct {
+	Visibility Visibility
+}
+
+// RulesetRules is a common subset of branch protection rules supported
+// across forges. Providers that cannot express a given field ignore it.
+type RulesetRules struct {
+	RequirePullRequest           bool
+	RequiredApprovingReviewCount int
+	RequiredStatusChecks         []string
+}
+
+// Provider is the interface implemented by every supported Git forge
+// backend. It mirrors the operations this module has historically exposed
+// for GitHub, so existing callers only need to swap their constructor.
+type Provider interface {
+	GetRepository(ctx context.Context, owner, name string) (*Repository, error)
+	CreateRepository(ctx context.Context, organization, name string, opts RepoOptions) (*Repository, error)
+	CreateBranch(ctx context.Context, owner, repoName, branchName string) (*Reference, error)
+	CreateOrUpdateFile(ctx context.Context, owner, repoName, branch, commitMessage, filePath, replacingFileSHA string, fileContent []byte) (*Reference, error)
+	CreatePullRequest(ctx context.Context, owner, repoName, title, body, head, base string) (*PullRequest, error)
+	ReplaceTopics(ctx context.Context, owner, repoName string, topics []string) ([]string, error)
+	CreateOrUpdateRepositorySecret(ctx context.Context, owner, repoName, secretName, secretValue string) error
+	CreateRepositoryRuleset(ctx context.Context, owner, repoName, rulesetName string, rules RulesetRules) error
+	GetRepositoryContent(ctx context.Context, owner, repoName, path, ref string) (fileContent []byte, directoryContent []string, err error)
+}
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/go-github/v73/github"
+)
+
+// Op identifies the kind of change CommitFiles should make to a given path.
+type Op string
+
+const (
+	OpCreate Op = "create"
+	OpUpdate Op = "update"
+	OpDelete Op = "delete"
+)
+
+// FileChange describes a single path to create, update, or delete as part
+// of a CommitFiles call.
+type FileChange struct {
+	Path    string
+	Content []byte
+	Op      Op
+}
+
+// treeEntry describes one path to create, update, or delete in a tree
+// built by createTree.
+type treeEntry struct {
+	Path    string
+	Content string
+	Delete  bool
+}
+
+// CommitFiles applies one or more FileChange entries to branch as a single
+// commit, instead of the one-commit-per-file behavior of CreateOrUpdateFile.
+//
+// It builds one tree from the branch tip plus the given changes (mode
+// 100644 for created/updated blobs, a nil-SHA entry to remove deleted
+// paths) and creates a single commit on top, then fast-forwards branch to
+// it. If gh.Signing is set, the commit is GPG-signed.
+func (gh *Github) CommitFiles(ctx context.Context, owner, repoName, branch, message string, files []FileChange) (*github.RepositoryContentResponse, error) {
+	gh.Logger.Debug("committing files", slog.String("repo name", repoName), slog.String("branch name", branch), slog.Int("file count", len(files)))
+
+	ref, _, err := gh.Client.Git.GetRef(ctx, owner, repoName, "refs/heads/"+branch)
+	if err != nil {
+		return nil, fmt.Errorf("error getting branch ref %s: %w", branch, err)
+	}
+
+	parentCommit, _, err := gh.Client.Git.GetCommit(ctx, owner, repoName, *ref.Object.SHA)
+	if err != nil {
+		return nil, fmt.Errorf("error getting parent commit %s: %w", *ref.Object.SHA, err)
+	}
+
+	entries := make([]treeEntry, 0, len(files))
+	for _, f := range files {
+		if f.Op == OpDelete {
+			entries = append(entries, treeEntry{Path: f.Path, Delete: true})
+			continue
+		}
+		entries = append(entries, treeEntry{Path: f.Path, Content: string(f.Content)})
+	}
+
+	commit, err := gh.createCommit(ctx, owner, repoName, parentCommit, message, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := gh.Client.Git.UpdateRef(ctx, owner, repoName, &github.Reference{
+		Ref:    github.Ptr("refs/heads/" + branch),
+		Object: &github.GitObject{SHA: commit.SHA},
+	}, false); err != nil {
+		gh.Logger.Debug("error fast-forwarding branch ref", slog.String("repo name", repoName), slog.String("branch name", branch))
+		return nil, fmt.Errorf("error updating branch ref %s: %w", branch, err)
+	}
+
+	return &github.RepositoryContentResponse{
+		Commit: github.Commit{
+			SHA:          commit.SHA,
+			Message:      commit.Message,
+			Verification: commit.Verification,
+		},
+	}, nil
+}
+
+// createCommit creates a tree from entries on top of parent's tree and a
+// commit pointing at it, signing it with gh.Signing when configured. It
+// does not move any ref.
+func (gh *Github) createCommit(ctx context.Context, owner, repoName string, parent *github.Commit, message string, entries []treeEntry) (*github.Commit, error) {
+	tree, err := gh.createTree(ctx, owner, repoName, *parent.Tree.SHA, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	if gh.Signing == nil {
+		commit, _, err := gh.Client.Git.CreateCommit(ctx, owner, repoName, &github.Commit{
+			Message: github.Ptr(message),
+			Tree:    tree,
+			Parents: []*github.Commit{parent},
+		}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating commit: %w", err)
+		}
+
+		return commit, nil
+	}
+
+	return gh.createSignedCommit(ctx, owner, repoName, tree, parent, message)
+}
+
+// treeEntryRequest is the wire shape of one tree entry in a "create a
+// tree" request. SHA is a tri-state **string instead of go-github's plain
+// *string/omitempty: a nil field is omitted (keep the existing blob at
+// this path), a non-nil field pointing at a nil *string marshals as an
+// explicit "sha": null (delete the path), and a non-nil field pointing at
+// a non-nil *string marshals as that SHA. A plain *string with omitempty
+// cannot express the null case at all, since omitempty drops the field
+// whenever the pointer itself is nil.
+type treeEntryRequest struct {
+	Path    string   `json:"path"`
+	Mode    string   `json:"mode,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Content *string  `json:"content,omitempty"`
+	SHA     **string `json:"sha,omitempty"`
+}
+
+// createTree creates a tree from entries on top of baseTreeSHA. Delete
+// entries are sent with an explicit "sha": null so GitHub actually removes
+// the path, rather than relying on go-github's TreeEntry, whose
+// omitempty-tagged SHA field silently drops a nil SHA instead of
+// serializing it as null.
+func (gh *Github) createTree(ctx context.Context, owner, repoName, baseTreeSHA string, entries []treeEntry) (*github.Tree, error) {
+	requestEntries := make([]treeEntryRequest, 0, len(entries))
+	for _, e := range entries {
+		if e.Delete {
+			var nilSHA *string
+			requestEntries = append(requestEntries, treeEntryRequest{Path: e.Path, SHA: &nilSHA})
+			continue
+		}
+
+		requestEntries = append(requestEntries, treeEntryRequest{
+			Path:    e.Path,
+			Mode:    "100644",
+			Type:    "blob",
+			Content: github.Ptr(e.Content),
+		})
+	}
+
+	body := &struct {
+		BaseTree string             `json:"base_tree,omitempty"`
+		Tree     []treeEntryRequest `json:"tree"`
+	}{
+		BaseTree: baseTreeSHA,
+		Tree:     requestEntries,
+	}
+
+	req, err := gh.Client.NewRequest("POST", fmt.Sprintf("repos/%s/%s/git/trees", owner, repoName), body)
+	if err != nil {
+		return nil, fmt.Errorf("error building tree request: %w", err)
+	}
+
+	tree := new(github.Tree)
+	if _, err := gh.Client.Do(ctx, req, tree); err != nil {
+		return nil, fmt.Errorf("error creating tree: %w", err)
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,83 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateTreeSendsExplicitNullSHAForDeletes(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("error reading request body: %v", err)
+		}
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Fatalf("error unmarshalling request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"sha":"newtreesha"}`))
+	}))
+	defer server.Close()
+
+	gh := New(slog.Default(), "token")
+	baseURL, err := http.NewRequest(http.MethodGet, server.URL+"/", nil)
+	if err != nil {
+		t.Fatalf("error building base url request: %v", err)
+	}
+	gh.Client.BaseURL = baseURL.URL
+
+	tree, err := gh.createTree(context.Background(), "owner", "repo", "basetreesha", []treeEntry{
+		{Path: "keep.txt", Content: "unchanged"},
+		{Path: "removed.txt", Delete: true},
+	})
+	if err != nil {
+		t.Fatalf("createTree returned an error: %v", err)
+	}
+	if tree.GetSHA() != "newtreesha" {
+		t.Errorf("tree SHA = %q, want %q", tree.GetSHA(), "newtreesha")
+	}
+
+	entries, ok := body["tree"].([]any)
+	if !ok || len(entries) != 2 {
+		t.Fatalf("tree entries = %v, want 2 entries", body["tree"])
+	}
+
+	kept := entries[0].(map[string]any)
+	if _, hasSHA := kept["sha"]; hasSHA {
+		t.Errorf("kept entry has a sha field, want it omitted entirely: %v", kept)
+	}
+
+	removed := entries[1].(map[string]any)
+	sha, hasSHA := removed["sha"]
+	if !hasSHA {
+		t.Fatalf("deleted entry has no sha field, want explicit null: %v", removed)
+	}
+	if sha != nil {
+		t.Errorf("deleted entry sha = %v, want null", sha)
+	}
+	for _, field := range []string{"mode", "type", "content"} {
+		if _, present := removed[field]; present {
+			t.Errorf("deleted entry has %q field, want it omitted: %v", field, removed)
+		}
+	}
+
+	if raw := strings.TrimSpace(string(marshalMust(t, body["tree"]))); !strings.Contains(raw, `"sha":null`) {
+		t.Errorf("marshalled tree = %s, want a literal \"sha\":null", raw)
+	}
+}
+
+func marshalMust(t *testing.T, v any) []byte {
+	t.Helper()
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("error marshalling %v: %v", v, err)
+	}
+	return raw
+}
@@ -1,52 +1,124 @@
-package gh
+// Package github is the GitHub implementation of the forge.Provider
+// interface, backed by google/go-github.
+package github
 
 import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"strings"
 
 	"github.com/google/go-github/v73/github"
 )
 
+// Github is a thin wrapper around the go-github client. Its methods use
+// go-github's native types directly so that callers who need GitHub-specific
+// behavior are not forced through the lowest-common-denominator
+// forge.Provider interface. Use NewProvider to get a forge.Provider-shaped
+// view of this client.
+type Github struct {
+	Logger *slog.Logger
+	Client *github.Client
+
+	// Token is the authentication token Client was built with. It is kept
+	// around so Sync can embed it in git clone/push URLs.
+	Token string
+
+	// Signing, when set, causes CreateOrUpdateFile and CommitFiles to
+	// produce GPG-signed ("Verified") commits through the Git Data API
+	// instead of going through the contents API.
+	Signing *SigningConfig
+}
+
 // New creates a new instance of the Github client with the provided logger and authentication token.
+//
+// Requests made through the returned client transparently wait out GitHub's
+// rate limits and retry 5xx responses with backoff.
 func New(logger *slog.Logger, token string) *Github {
-	client := github.NewClient(nil).WithAuthToken(token)
+	httpClient := &http.Client{Transport: newRetryingTransport(nil)}
+	client := github.NewClient(httpClient).WithAuthToken(token)
 	return &Github{
 		Logger: logger.With("common", "github"),
 		Client: client,
+		Token:  token,
 	}
 }
 
+// NewWithBaseURL creates a new instance of the Github client targeting a
+// GitHub Enterprise Server or GitHub AE installation instead of the public
+// github.com SaaS endpoint.
+//
+// baseURL and uploadURL are the instance's REST API and uploads endpoints,
+// e.g. "https://ghes.example.com/api/v3/" and
+// "https://ghes.example.com/api/uploads/". All other Github methods work
+// unchanged against the returned client.
+func NewWithBaseURL(logger *slog.Logger, token, baseURL, uploadURL string) (*Github, error) {
+	httpClient := &http.Client{Transport: newRetryingTransport(nil)}
+	client, err := github.NewClient(httpClient).WithAuthToken(token).WithEnterpriseURLs(baseURL, uploadURL)
+	if err != nil {
+		return nil, fmt.Errorf("error creating enterprise github client: %w", err)
+	}
+
+	return &Github{
+		Logger: logger.With("common", "github"),
+		Client: client,
+		Token:  token,
+	}, nil
+}
+
 // GetRepository gets a repository as specified by the owner/name parameters.
 func (gh *Github) GetRepository(ctx context.Context, owner, name string) (*github.Repository, error) {
 	gh.Logger.Debug("get repository", slog.String("owner", owner), slog.String("repo name", name))
 	repo, res, err := gh.Client.Repositories.Get(ctx, owner, name)
-	if res.StatusCode == 404 {
+	if res != nil && res.StatusCode == 404 {
 		gh.Logger.Debug("repository not found", slog.String("owner", owner), slog.String("repo name", name))
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf(fmt.Sprintf("error getting repository %s/%s", owner, name), err)
+		return nil, fmt.Errorf("error getting repository %s/%s: %w", owner, name, err)
 	}
 
 	return repo, nil
 }
 
+// Visibility controls the visibility of a repository created by CreateRepository.
+type Visibility string
+
+const (
+	VisibilityPublic  Visibility = "public"
+	VisibilityPrivate Visibility = "private"
+	// VisibilityInternal is only available on GHES/GHAE instances.
+	VisibilityInternal Visibility = "internal"
+)
+
+// RepoOptions controls repository creation.
+type RepoOptions struct {
+	Visibility Visibility
+}
+
 // CreateRepository creates a new repository as specified by the organization/name.
 //
 // If authenticated as user, pass an empty organization string to create the repository under the authenticated user.
-// Repositories created by this function are public by default (private repositories are not created due to rulesets limitations).
+// If organization is set and does not exist yet, it is created first via the
+// GHES-only Admin API.
 //
 // Default branch name is set to your configuration on Github.
 // Branches are deleted when merged by default.
 // A README.md file is created by default.
 //
 // Changes can have propagation time on GH's servers.
-func (gh *Github) CreateRepository(ctx context.Context, organization, name string) (*github.Repository, error) {
-	gh.Logger.Debug("creating repository", slog.String("organization", organization), slog.String("name", name))
-	repo, res, err := gh.Client.Repositories.Create(ctx, "", &github.Repository{
+func (gh *Github) CreateRepository(ctx context.Context, organization, name string, opts RepoOptions) (*github.Repository, error) {
+	gh.Logger.Debug("creating repository", slog.String("organization", organization), slog.String("name", name), slog.String("visibility", string(opts.Visibility)))
+
+	if organization != "" {
+		if err := gh.ensureOrganization(ctx, organization); err != nil {
+			return nil, err
+		}
+	}
+
+	newRepo := &github.Repository{
 		Name:                      github.Ptr(name),
-		Private:                   github.Ptr(false),
 		HasIssues:                 github.Ptr(true),
 		HasProjects:               github.Ptr(false),
 		HasWiki:                   github.Ptr(false),
@@ -55,9 +127,21 @@ func (gh *Github) CreateRepository(ctx context.Context, organization, name strin
 		DeleteBranchOnMerge:       github.Ptr(true),
 		UseSquashPRTitleAsDefault: github.Ptr(true),
 		AllowForking:              github.Ptr(true),
-	})
+	}
 
-	if res.StatusCode == 422 {
+	switch opts.Visibility {
+	case VisibilityInternal:
+		newRepo.Visibility = github.Ptr("internal")
+		newRepo.Private = github.Ptr(true)
+	case VisibilityPrivate:
+		newRepo.Private = github.Ptr(true)
+	default:
+		newRepo.Private = github.Ptr(false)
+	}
+
+	repo, res, err := gh.Client.Repositories.Create(ctx, organization, newRepo)
+
+	if res != nil && res.StatusCode == 422 {
 		gh.Logger.Debug("validation failed", slog.String("organization", organization), slog.String("name", name))
 	}
 
@@ -69,6 +153,27 @@ func (gh *Github) CreateRepository(ctx context.Context, organization, name strin
 	return repo, nil
 }
 
+// ensureOrganization makes sure organization exists, creating it via the
+// GHES-only Admin API when Organizations.Get returns a 404.
+func (gh *Github) ensureOrganization(ctx context.Context, organization string) error {
+	_, res, err := gh.Client.Organizations.Get(ctx, organization)
+	if err == nil {
+		return nil
+	}
+
+	if res == nil || res.StatusCode != 404 {
+		return fmt.Errorf("error checking organization %s: %w", organization, err)
+	}
+
+	gh.Logger.Debug("organization not found, creating it", slog.String("organization", organization))
+	if _, _, err := gh.Client.Admin.CreateOrg(ctx, &github.Organization{Login: github.Ptr(organization)}, ""); err != nil {
+		gh.Logger.Debug("error creating organization", slog.String("organization", organization))
+		return fmt.Errorf("error creating organization %s: %w", organization, err)
+	}
+
+	return nil
+}
+
 // CreateBranch creates a new branch in the specified repository.
 //
 // Takes the last commit from the default branch and creates a new branch with the specified name.
@@ -106,8 +211,16 @@ func (gh *Github) CreateBranch(ctx context.Context, owner, repoName, branchName
 //
 // If a file is being updated, a SHA is required for the file that is being updated.
 // Returns the parsed response from CreateFile operation in the Github's API.
+//
+// If gh.Signing is set, the commit is built and GPG-signed through the Git
+// Data API instead, producing a "Verified" commit.
 func (gh *Github) CreateOrUpdateFile(ctx context.Context, owner, repoName, branch, commitMessage, filePath, replacingFileSHA string, fileContent []byte) (*github.RepositoryContentResponse, error) {
 	gh.Logger.Debug("creating file", slog.String("repo name", repoName), slog.String("branch name", branch), slog.String("file path", filePath))
+
+	if gh.Signing != nil {
+		return gh.createOrUpdateFileSigned(ctx, owner, repoName, branch, commitMessage, filePath, fileContent)
+	}
+
 	content, _, err := gh.Client.Repositories.CreateFile(ctx, owner, repoName, filePath, &github.RepositoryContentFileOptions{
 		Message: github.Ptr(commitMessage),
 		Content: fileContent,
@@ -127,22 +240,88 @@ func (gh *Github) CreateOrUpdateFile(ctx context.Context, owner, repoName, branc
 //
 // A path can be specified. If an empty path is passed, the function will return the content of the root directory.
 // A ref can be specified.
+//
+// Directory listings go through listDirectoryContent (the Git Trees API)
+// rather than the Contents API: the Contents API silently truncates
+// directories over 1,000 entries with no way to page further, while the
+// Trees API reports truncation explicitly via Tree.Truncated.
 func (gh *Github) GetRepositoryContent(ctx context.Context, owner, repoName, path, ref string) (fileContent *github.RepositoryContent, directoryContent []*github.RepositoryContent, err error) {
 	gh.Logger.Debug("getting repository content", slog.String("repo name", repoName), slog.String("ref", ref), slog.String("path", path))
-	fileContents, dirContents, res, err := gh.Client.Repositories.GetContents(ctx, owner, repoName, path, &github.RepositoryContentGetOptions{
+	fileContents, _, res, err := gh.Client.Repositories.GetContents(ctx, owner, repoName, path, &github.RepositoryContentGetOptions{
 		Ref: ref,
 	})
 
-	if res.StatusCode == 404 {
+	if res != nil && res.StatusCode == 404 {
 		gh.Logger.Debug("file/path not found", slog.String("repo name", repoName), slog.String("ref", ref), slog.String("path", path))
 	}
 
-	if err != nil && res.StatusCode != 404 {
+	if err != nil && (res == nil || res.StatusCode != 404) {
 		gh.Logger.Debug("error getting repository content", slog.String("repo name", repoName), slog.String("ref", ref), slog.String("path", path))
 		return nil, nil, err
 	}
 
-	return fileContents, dirContents, nil
+	if fileContents != nil {
+		return fileContents, nil, nil
+	}
+
+	dirContents, err := gh.listDirectoryContent(ctx, owner, repoName, path, ref)
+	if err != nil {
+		gh.Logger.Debug("error listing directory content", slog.String("repo name", repoName), slog.String("ref", ref), slog.String("path", path))
+		return nil, nil, err
+	}
+
+	return nil, dirContents, nil
+}
+
+// listDirectoryContent lists path's direct children via the Git Trees API.
+// An empty ref resolves to the repository's default branch.
+func (gh *Github) listDirectoryContent(ctx context.Context, owner, repoName, path, ref string) ([]*github.RepositoryContent, error) {
+	if ref == "" {
+		repo, err := gh.GetRepository(ctx, owner, repoName)
+		if err != nil {
+			return nil, err
+		}
+		ref = *repo.DefaultBranch
+	}
+
+	tree, _, err := gh.Client.Git.GetTree(ctx, owner, repoName, ref, true)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tree for ref %s: %w", ref, err)
+	}
+
+	if tree.GetTruncated() {
+		gh.Logger.Debug("tree response truncated, directory listing may be incomplete", slog.String("repo name", repoName), slog.String("ref", ref), slog.String("path", path))
+	}
+
+	prefix := strings.TrimSuffix(path, "/")
+	entries := make([]*github.RepositoryContent, 0, len(tree.Entries))
+	for _, e := range tree.Entries {
+		entryPath := e.GetPath()
+		if prefix != "" {
+			if !strings.HasPrefix(entryPath, prefix+"/") {
+				continue
+			}
+			entryPath = strings.TrimPrefix(entryPath, prefix+"/")
+		}
+		if strings.Contains(entryPath, "/") {
+			continue // not a direct child of path
+		}
+
+		contentType := "file"
+		if e.GetType() == "tree" {
+			contentType = "dir"
+		}
+
+		entries = append(entries, &github.RepositoryContent{
+			Type: github.Ptr(contentType),
+			Name: github.Ptr(entryPath),
+			Path: e.Path,
+			SHA:  e.SHA,
+			Size: e.Size,
+		})
+	}
+
+	return entries, nil
 }
 
 // ReplaceTopics replaces the topics of a repository.
@@ -166,6 +345,7 @@ func (gh *Github) CreateOrUpdateRepositorySecret(ctx context.Context, owner, rep
 	key, _, err := gh.Client.Actions.GetRepoPublicKey(ctx, owner, repoName)
 	if err != nil {
 		gh.Logger.Debug("error getting repository public key", slog.String("repo name", repoName))
+		return err
 	}
 
 	encryptedSecret, err := gh.encryptSecret(*key.Key, secretValue)
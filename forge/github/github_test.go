@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+)
+
+// erroringTransport fails every request without producing an *http.Response,
+// simulating a transport-level failure (connection refused, DNS failure,
+// etc.) where go-github returns a nil *Response alongside the error.
+type erroringTransport struct{}
+
+func (erroringTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("simulated transport failure")
+}
+
+func TestGetRepositoryDoesNotPanicOnTransportFailure(t *testing.T) {
+	gh := New(slog.Default(), "token")
+	gh.Client.Client().Transport = erroringTransport{}
+
+	if _, err := gh.GetRepository(context.Background(), "owner", "repo"); err == nil {
+		t.Fatal("GetRepository returned no error on transport failure")
+	}
+}
+
+func TestCreateRepositoryDoesNotPanicOnTransportFailure(t *testing.T) {
+	gh := New(slog.Default(), "token")
+	gh.Client.Client().Transport = erroringTransport{}
+
+	if _, err := gh.CreateRepository(context.Background(), "", "repo", RepoOptions{}); err == nil {
+		t.Fatal("CreateRepository returned no error on transport failure")
+	}
+}
+
+func TestNewWithBaseURL(t *testing.T) {
+	logger := slog.Default()
+
+	gh, err := NewWithBaseURL(logger, "token", "https://ghes.example.com/api/v3/", "https://ghes.example.com/api/uploads/")
+	if err != nil {
+		t.Fatalf("NewWithBaseURL returned an error: %v", err)
+	}
+
+	if got := gh.Client.BaseURL.String(); got != "https://ghes.example.com/api/v3/" {
+		t.Errorf("BaseURL = %q, want %q", got, "https://ghes.example.com/api/v3/")
+	}
+
+	if got := gh.Client.UploadURL.String(); got != "https://ghes.example.com/api/uploads/" {
+		t.Errorf("UploadURL = %q, want %q", got, "https://ghes.example.com/api/uploads/")
+	}
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/go-github/v73/github"
+)
+
+// listAll drains every page of a paginated go-github list call, so callers
+// don't silently get only the first page back.
+func listAll[T any](ctx context.Context, fn func(*github.ListOptions) ([]T, *github.Response, error)) ([]T, error) {
+	var all []T
+	opt := &github.ListOptions{PerPage: 100}
+
+	for {
+		items, resp, err := fn(opt)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListRepositories lists every repository in organization, following pagination.
+func (gh *Github) ListRepositories(ctx context.Context, organization string) ([]*github.Repository, error) {
+	gh.Logger.Debug("listing repositories", slog.String("organization", organization))
+
+	repos, err := listAll(ctx, func(opt *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+		return gh.Client.Repositories.ListByOrg(ctx, organization, &github.RepositoryListByOrgOptions{ListOptions: *opt})
+	})
+	if err != nil {
+		gh.Logger.Debug("error listing repositories", slog.String("organization", organization))
+		return nil, err
+	}
+
+	return repos, nil
+}
+
+// ListBranches lists every branch in the specified repository, following pagination.
+func (gh *Github) ListBranches(ctx context.Context, owner, repoName string) ([]*github.Branch, error) {
+	gh.Logger.Debug("listing branches", slog.String("owner", owner), slog.String("repo name", repoName))
+
+	branches, err := listAll(ctx, func(opt *github.ListOptions) ([]*github.Branch, *github.Response, error) {
+		return gh.Client.Repositories.ListBranches(ctx, owner, repoName, &github.BranchListOptions{ListOptions: *opt})
+	})
+	if err != nil {
+		gh.Logger.Debug("error listing branches", slog.String("owner", owner), slog.String("repo name", repoName))
+		return nil, err
+	}
+
+	return branches, nil
+}
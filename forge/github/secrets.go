@@ -0,0 +1,33 @@
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+// encryptSecret encrypts value for GitHub's secrets API using a
+// repository's public key (the base64-encoded NaCl box key returned by
+// GetRepoPublicKey), producing the base64-encoded sealed box
+// CreateOrUpdateRepoSecret expects in EncryptedSecret.EncryptedValue.
+func (gh *Github) encryptSecret(publicKeyBase64, value string) (string, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(publicKeyBase64)
+	if err != nil {
+		return "", fmt.Errorf("error decoding repository public key: %w", err)
+	}
+	if len(rawKey) != 32 {
+		return "", fmt.Errorf("invalid repository public key length %d, want 32", len(rawKey))
+	}
+
+	var recipientKey [32]byte
+	copy(recipientKey[:], rawKey)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &recipientKey, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("error sealing secret: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
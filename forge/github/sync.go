@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v73/github"
+)
+
+// SyncSource identifies the repository to mirror from: either a raw git
+// clone URL, or another Github client plus an owner/repo pair (e.g. a
+// source GHES instance authenticated separately from gh).
+type SyncSource struct {
+	// CloneURL, if set, is used directly instead of deriving one from
+	// Client/Owner/Repo.
+	CloneURL string
+	Client   *Github
+	Owner    string
+	Repo     string
+}
+
+// SyncDest identifies the repository to mirror into. It is created via
+// CreateRepository if it does not already exist.
+type SyncDest struct {
+	Organization string
+	Repo         string
+	Visibility   Visibility
+}
+
+// SyncOptions controls Sync's behavior.
+type SyncOptions struct {
+	// CacheDir is where the intermediate --mirror clone is created. A
+	// temporary directory is used and removed afterwards if empty. If set,
+	// it is reused across calls: any existing mirror clone for dst.Repo is
+	// removed first, so a caller may safely pass the same CacheDir to
+	// repeated Sync calls.
+	CacheDir string
+	// DisablePush skips the final `git push --mirror`, for dry runs.
+	DisablePush bool
+}
+
+// Sync mirrors src into dst: it does a `git clone --mirror` of src into a
+// cache directory, creates dst via CreateRepository if it doesn't exist
+// yet, then `git push --mirror`s the clone into dst using gh's
+// authenticated token. Useful for copying template repos,
+// disaster-recovery mirrors, and GHES seeding.
+func (gh *Github) Sync(ctx context.Context, src SyncSource, dst SyncDest, opts SyncOptions) error {
+	gh.Logger.Debug("syncing repository", slog.String("dest organization", dst.Organization), slog.String("dest repo", dst.Repo))
+
+	sourceURL, err := src.cloneURL()
+	if err != nil {
+		return fmt.Errorf("error resolving source clone url: %w", err)
+	}
+
+	cacheDir := opts.CacheDir
+	if cacheDir == "" {
+		dir, err := os.MkdirTemp("", "go-common-sync-*")
+		if err != nil {
+			return fmt.Errorf("error creating sync cache dir: %w", err)
+		}
+		cacheDir = dir
+		defer os.RemoveAll(cacheDir)
+	}
+
+	mirrorDir := filepath.Join(cacheDir, dst.Repo+".git")
+	if err := os.RemoveAll(mirrorDir); err != nil {
+		return fmt.Errorf("error clearing previous mirror clone: %w", err)
+	}
+	if err := runGit(ctx, "", "clone", "--mirror", sourceURL, mirrorDir); err != nil {
+		gh.Logger.Debug("error mirror-cloning source repository", slog.String("dest repo", dst.Repo))
+		return fmt.Errorf("error mirror-cloning source repository: %w", err)
+	}
+
+	if _, err := gh.GetRepository(ctx, dst.Organization, dst.Repo); err != nil {
+		if !isNotFound(err) {
+			return fmt.Errorf("error checking destination repository: %w", err)
+		}
+		gh.Logger.Debug("destination repository not found, creating it", slog.String("organization", dst.Organization), slog.String("repo", dst.Repo))
+		if _, err := gh.CreateRepository(ctx, dst.Organization, dst.Repo, RepoOptions{Visibility: dst.Visibility}); err != nil {
+			return fmt.Errorf("error creating destination repository: %w", err)
+		}
+	}
+
+	if opts.DisablePush {
+		gh.Logger.Debug("push disabled, skipping push --mirror", slog.String("organization", dst.Organization), slog.String("repo", dst.Repo))
+		return nil
+	}
+
+	destURL := gh.remoteURL(dst.Organization, dst.Repo)
+	if err := runGit(ctx, mirrorDir, "push", "--mirror", destURL); err != nil {
+		gh.Logger.Debug("error mirror-pushing to destination repository", slog.String("organization", dst.Organization), slog.String("repo", dst.Repo))
+		return fmt.Errorf("error mirror-pushing to destination repository: %w", err)
+	}
+
+	return nil
+}
+
+// isNotFound reports whether err is a go-github 404 ErrorResponse, as
+// opposed to a transient failure (5xx, rate limiting, network error) that
+// should be propagated rather than treated as "repository doesn't exist
+// yet".
+func isNotFound(err error) bool {
+	var ghErr *github.ErrorResponse
+	return errors.As(err, &ghErr) && ghErr.Response != nil && ghErr.Response.StatusCode == http.StatusNotFound
+}
+
+// SyncAll mirrors every "owner/repo[:dest_owner/dest_repo]" mapping in
+// mappings, using gh as both the default source and destination client.
+func (gh *Github) SyncAll(ctx context.Context, mappings []string, opts SyncOptions) error {
+	for _, mapping := range mappings {
+		sourceOwner, sourceRepo, destOwner, destRepo, err := parseSyncMapping(mapping)
+		if err != nil {
+			return fmt.Errorf("error parsing sync mapping %q: %w", mapping, err)
+		}
+
+		src := SyncSource{Client: gh, Owner: sourceOwner, Repo: sourceRepo}
+		dst := SyncDest{Organization: destOwner, Repo: destRepo}
+
+		if err := gh.Sync(ctx, src, dst, opts); err != nil {
+			return fmt.Errorf("error syncing %s/%s: %w", sourceOwner, sourceRepo, err)
+		}
+	}
+
+	return nil
+}
+
+// parseSyncMapping parses "owner/repo[:dest_owner/dest_repo]", defaulting
+// the destination to the source when no ":dest_owner/dest_repo" suffix is given.
+func parseSyncMapping(mapping string) (sourceOwner, sourceRepo, destOwner, destRepo string, err error) {
+	source, dest, hasDest := strings.Cut(mapping, ":")
+
+	sourceOwner, sourceRepo, ok := strings.Cut(source, "/")
+	if !ok {
+		return "", "", "", "", fmt.Errorf("invalid mapping %q: expected owner/repo", mapping)
+	}
+
+	if !hasDest {
+		return sourceOwner, sourceRepo, sourceOwner, sourceRepo, nil
+	}
+
+	destOwner, destRepo, ok = strings.Cut(dest, "/")
+	if !ok {
+		return "", "", "", "", fmt.Errorf("invalid mapping %q: expected dest_owner/dest_repo", mapping)
+	}
+
+	return sourceOwner, sourceRepo, destOwner, destRepo, nil
+}
+
+// cloneURL resolves the git URL to clone src from.
+func (s SyncSource) cloneURL() (string, error) {
+	if s.CloneURL != "" {
+		return s.CloneURL, nil
+	}
+
+	if s.Client == nil {
+		return "", fmt.Errorf("sync source must set either CloneURL or Client")
+	}
+
+	return s.Client.remoteURL(s.Owner, s.Repo), nil
+}
+
+// remoteURL builds an authenticated HTTPS clone/push URL for owner/repo on
+// gh's GitHub instance, deriving the git host from the API's BaseURL.
+func (gh *Github) remoteURL(owner, repo string) string {
+	host := "github.com"
+	scheme := "https"
+	if gh.Client.BaseURL != nil && gh.Client.BaseURL.Host != "api.github.com" {
+		host = gh.Client.BaseURL.Host
+		scheme = gh.Client.BaseURL.Scheme
+	}
+
+	u := url.URL{
+		Scheme: scheme,
+		User:   url.UserPassword("x-access-token", gh.Token),
+		Host:   host,
+		Path:   fmt.Sprintf("/%s/%s.git", owner, repo),
+	}
+
+	return u.String()
+}
+
+// runGit runs git with args in dir (the current process directory if
+// empty), returning an error that includes combined output on failure.
+//
+// args and the command's output may contain an authenticated clone/push URL
+// (see remoteURL), so the returned error has any embedded URL credentials
+// redacted before it reaches a caller's log.
+func runGit(ctx context.Context, dir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return redactCredentials(fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, out))
+	}
+
+	return nil
+}
+
+// credentialURLPattern matches the userinfo portion of a URL, e.g.
+// "x-access-token:ghp_xxx@" in an authenticated git remote URL.
+var credentialURLPattern = regexp.MustCompile(`://[^/@\s]+@`)
+
+// redactCredentials replaces any URL userinfo embedded in err's message
+// with "://***@", so a token passed via remoteURL never leaks into a log
+// or returned error.
+func redactCredentials(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.New(credentialURLPattern.ReplaceAllString(err.Error(), "://***@"))
+}
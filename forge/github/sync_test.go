@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsNotFoundDistinguishes404FromOtherErrors(t *testing.T) {
+	newClient := func(status int) *Github {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+		t.Cleanup(server.Close)
+
+		gh := New(slog.Default(), "token")
+		baseURL, err := http.NewRequest(http.MethodGet, server.URL+"/", nil)
+		if err != nil {
+			t.Fatalf("error building base url request: %v", err)
+		}
+		gh.Client.BaseURL = baseURL.URL
+		return gh
+	}
+
+	t.Run("404", func(t *testing.T) {
+		gh := newClient(http.StatusNotFound)
+		_, err := gh.GetRepository(context.Background(), "owner", "repo")
+		if err == nil {
+			t.Fatal("GetRepository returned no error for a 404 response")
+		}
+		if !isNotFound(err) {
+			t.Errorf("isNotFound(%v) = false, want true", err)
+		}
+	})
+
+	t.Run("500", func(t *testing.T) {
+		gh := newClient(http.StatusInternalServerError)
+
+		// GetRepository retries 5xx with backoff; bound the retries with a
+		// short deadline so the test doesn't wait out the full backoff
+		// schedule.
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := gh.GetRepository(ctx, "owner", "repo")
+		if err == nil {
+			t.Fatal("GetRepository returned no error for a 500 response")
+		}
+		if isNotFound(err) {
+			t.Errorf("isNotFound(%v) = true, want false", err)
+		}
+	})
+}
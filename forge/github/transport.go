@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRetries bounds how many times a single request is retried after a
+// rate-limit wait or a 5xx response before giving up.
+const maxRetries = 5
+
+// retryingTransport wraps an http.RoundTripper so that every request made
+// through Github.Client transparently honors GitHub's primary and
+// secondary rate limits (sleeping until Retry-After/X-RateLimit-Reset) and
+// retries 5xx responses with exponential backoff and jitter.
+type retryingTransport struct {
+	base http.RoundTripper
+}
+
+func newRetryingTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &retryingTransport{base: base}
+}
+
+func (t *retryingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			if rerr := rewindBody(req); rerr != nil {
+				return resp, rerr
+			}
+		}
+
+		resp, err = t.base.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+
+		if wait, limited := rateLimitWait(resp); limited && attempt < maxRetries {
+			drainAndClose(resp)
+			if werr := sleep(req.Context(), wait); werr != nil {
+				return resp, werr
+			}
+			continue
+		}
+
+		// Only idempotent requests are safe to retry on a 5xx: the server
+		// may have already applied a non-idempotent write (e.g. created a
+		// repo or commit) before failing the response.
+		if resp.StatusCode >= http.StatusInternalServerError && attempt < maxRetries && isIdempotent(req.Method) {
+			drainAndClose(resp)
+			if werr := sleep(req.Context(), backoff(attempt)); werr != nil {
+				return resp, werr
+			}
+			continue
+		}
+
+		return resp, nil
+	}
+
+	return resp, err
+}
+
+// rewindBody resets req.Body from req.GetBody before a retry, so the
+// retried request resends its original payload instead of the
+// already-drained, empty body left over from the first attempt.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("error rewinding request body for retry: %w", err)
+	}
+
+	req.Body = body
+	return nil
+}
+
+// isIdempotent reports whether method is safe to retry after a 5xx without
+// risking a duplicate side effect, such as a duplicate repo or commit.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// drainAndClose drains and closes resp.Body so the underlying connection
+// can be reused for the retry.
+func drainAndClose(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// rateLimitWait inspects the response for GitHub's primary (X-RateLimit-*)
+// and secondary (Retry-After) rate limit signals, reporting how long to
+// wait before retrying.
+func rateLimitWait(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset := resp.Header.Get("X-RateLimit-Reset"); reset != "" {
+			if unix, err := strconv.ParseInt(reset, 10, 64); err == nil {
+				if wait := time.Until(time.Unix(unix, 0)); wait > 0 {
+					return wait, true
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// backoff computes an exponential delay with jitter for the given attempt.
+func backoff(attempt int) time.Duration {
+	base := time.Duration(1<<uint(attempt)) * time.Second
+	jitter := time.Duration(rand.Int63n(int64(time.Second)))
+	return base + jitter
+}
+
+// sleep waits for d, returning early with ctx.Err() if ctx is canceled first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
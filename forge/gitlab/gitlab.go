@@ -0,0 +1,287 @@
+// Package gitlab is the GitLab implementation of the forge.Provider
+// interface, backed by xanzy/go-gitlab.
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// GitLab is a thin wrapper around the go-gitlab client, mirroring the shape
+// of the GitHub client in this module.
+type GitLab struct {
+	Logger *slog.Logger
+	Client *gitlab.Client
+}
+
+// New creates a new instance of the GitLab client with the provided logger
+// and authentication token, targeting gitlab.com by default, or a
+// self-managed instance when baseURL is non-empty.
+func New(logger *slog.Logger, token, baseURL string) (*GitLab, error) {
+	opts := []gitlab.ClientOptionFunc{}
+	if baseURL != "" {
+		opts = append(opts, gitlab.WithBaseURL(baseURL))
+	}
+
+	client, err := gitlab.NewClient(token, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating gitlab client: %w", err)
+	}
+
+	return &GitLab{
+		Logger: logger.With("common", "gitlab"),
+		Client: client,
+	}, nil
+}
+
+// GetRepository gets a project as specified by the owner/name parameters.
+func (gl *GitLab) GetRepository(ctx context.Context, owner, name string) (*gitlab.Project, error) {
+	gl.Logger.Debug("get project", slog.String("owner", owner), slog.String("repo name", name))
+	project, _, err := gl.Client.Projects.GetProject(owner+"/"+name, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting project %s/%s: %w", owner, name, err)
+	}
+
+	return project, nil
+}
+
+// Visibility controls the visibility of a project created by CreateRepository.
+type Visibility string
+
+const (
+	VisibilityPublic   Visibility = "public"
+	VisibilityPrivate  Visibility = "private"
+	VisibilityInternal Visibility = "internal"
+)
+
+// toGitlabVisibility maps the forge-agnostic Visibility to go-gitlab's
+// visibility value, defaulting to public.
+func toGitlabVisibility(v Visibility) gitlab.VisibilityValue {
+	switch v {
+	case VisibilityPrivate:
+		return gitlab.PrivateVisibility
+	case VisibilityInternal:
+		return gitlab.InternalVisibility
+	default:
+		return gitlab.PublicVisibility
+	}
+}
+
+// RepoOptions controls repository creation.
+type RepoOptions struct {
+	Visibility Visibility
+}
+
+// CreateRepository creates a new project under the given namespace.
+func (gl *GitLab) CreateRepository(ctx context.Context, namespace, name string, opts RepoOptions) (*gitlab.Project, error) {
+	gl.Logger.Debug("creating project", slog.String("namespace", namespace), slog.String("name", name), slog.String("visibility", string(opts.Visibility)))
+
+	opt := &gitlab.CreateProjectOptions{
+		Name:       gitlab.Ptr(name),
+		Visibility: gitlab.Ptr(toGitlabVisibility(opts.Visibility)),
+	}
+
+	if namespace != "" {
+		group, _, err := gl.Client.Groups.GetGroup(namespace, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			gl.Logger.Debug("error resolving namespace", slog.String("namespace", namespace))
+			return nil, fmt.Errorf("error resolving namespace %s: %w", namespace, err)
+		}
+		opt.NamespaceID = gitlab.Ptr(group.ID)
+	}
+
+	project, _, err := gl.Client.Projects.CreateProject(opt, gitlab.WithContext(ctx))
+	if err != nil {
+		gl.Logger.Debug("error creating project", slog.String("namespace", namespace), slog.String("name", name))
+		return nil, fmt.Errorf("error creating project %s/%s: %w", namespace, name, err)
+	}
+
+	return project, nil
+}
+
+// CreateBranch creates a new branch in the specified project, starting from
+// the project's default branch.
+func (gl *GitLab) CreateBranch(ctx context.Context, owner, repoName, branchName string) (*gitlab.Branch, error) {
+	gl.Logger.Debug("creating branch", slog.String("owner", owner), slog.String("repo name", repoName), slog.String("branch name", branchName))
+	project, err := gl.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	branch, _, err := gl.Client.Branches.CreateBranch(project.ID, &gitlab.CreateBranchOptions{
+		Branch: gitlab.Ptr(branchName),
+		Ref:    gitlab.Ptr(project.DefaultBranch),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		gl.Logger.Debug("error creating new branch", slog.String("owner", owner), slog.String("repo name", repoName), slog.String("new branch name", branchName))
+		return nil, fmt.Errorf("error creating branch %s: %w", branchName, err)
+	}
+
+	return branch, nil
+}
+
+// CreateOrUpdateFile creates or updates a file in the specified project and branch.
+func (gl *GitLab) CreateOrUpdateFile(ctx context.Context, owner, repoName, branch, commitMessage, filePath string, fileContent []byte) (*gitlab.FileInfo, error) {
+	gl.Logger.Debug("creating file", slog.String("repo name", repoName), slog.String("branch name", branch), slog.String("file path", filePath))
+	project, err := gl.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	encoding := gitlab.Ptr("base64")
+	content := base64.StdEncoding.EncodeToString(fileContent)
+
+	file, _, err := gl.Client.RepositoryFiles.GetFile(project.ID, filePath, &gitlab.GetFileOptions{Ref: gitlab.Ptr(branch)}, gitlab.WithContext(ctx))
+	if err == nil && file != nil {
+		_, _, err := gl.Client.RepositoryFiles.UpdateFile(project.ID, filePath, &gitlab.UpdateFileOptions{
+			Branch:        gitlab.Ptr(branch),
+			Content:       gitlab.Ptr(content),
+			Encoding:      encoding,
+			CommitMessage: gitlab.Ptr(commitMessage),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error updating file %s: %w", filePath, err)
+		}
+		return &gitlab.FileInfo{FilePath: filePath, Branch: branch}, nil
+	}
+
+	created, _, err := gl.Client.RepositoryFiles.CreateFile(project.ID, filePath, &gitlab.CreateFileOptions{
+		Branch:        gitlab.Ptr(branch),
+		Content:       gitlab.Ptr(content),
+		Encoding:      encoding,
+		CommitMessage: gitlab.Ptr(commitMessage),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		gl.Logger.Debug("error creating file", slog.String("repo name", repoName), slog.String("branch name", branch), slog.String("file path", filePath))
+		return nil, fmt.Errorf("error creating file %s: %w", filePath, err)
+	}
+
+	return created, nil
+}
+
+// GetRepositoryContent gets the content of a file or directory in a project.
+func (gl *GitLab) GetRepositoryContent(ctx context.Context, owner, repoName, path, ref string) (fileContent []byte, directoryContent []*gitlab.TreeNode, err error) {
+	gl.Logger.Debug("getting repository content", slog.String("repo name", repoName), slog.String("ref", ref), slog.String("path", path))
+	project, err := gl.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if path != "" {
+		file, _, err := gl.Client.RepositoryFiles.GetFile(project.ID, path, &gitlab.GetFileOptions{Ref: gitlab.Ptr(ref)}, gitlab.WithContext(ctx))
+		if err == nil {
+			decoded, err := base64.StdEncoding.DecodeString(file.Content)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error decoding file content %s: %w", path, err)
+			}
+			return decoded, nil, nil
+		}
+	}
+
+	tree, _, err := gl.Client.Repositories.ListTree(project.ID, &gitlab.ListTreeOptions{Path: gitlab.Ptr(path), Ref: gitlab.Ptr(ref)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing repository tree %s: %w", path, err)
+	}
+
+	return nil, tree, nil
+}
+
+// ReplaceTopics replaces the topics of a project.
+func (gl *GitLab) ReplaceTopics(ctx context.Context, owner, repoName string, topics []string) ([]string, error) {
+	gl.Logger.Debug("replacing project topics", slog.String("repo name", repoName), slog.Any("topics", topics))
+	project, err := gl.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, _, err := gl.Client.Projects.EditProject(project.ID, &gitlab.EditProjectOptions{
+		Topics: gitlab.Ptr(topics),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		gl.Logger.Debug("error replacing project topics", slog.String("repo name", repoName), slog.Any("topics", topics))
+		return nil, fmt.Errorf("error replacing project topics: %w", err)
+	}
+
+	return updated.Topics, nil
+}
+
+// CreateOrUpdateRepositorySecret creates or updates a CI/CD variable in the specified project.
+func (gl *GitLab) CreateOrUpdateRepositorySecret(ctx context.Context, owner, repoName, secretName, secretValue string) error {
+	gl.Logger.Debug("creating or updating project variable", slog.String("repo name", repoName), slog.String("secret name", secretName))
+	project, err := gl.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = gl.Client.ProjectVariables.CreateVariable(project.ID, &gitlab.CreateProjectVariableOptions{
+		Key:   gitlab.Ptr(secretName),
+		Value: gitlab.Ptr(secretValue),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		_, _, err = gl.Client.ProjectVariables.UpdateVariable(project.ID, secretName, &gitlab.UpdateProjectVariableOptions{
+			Value: gitlab.Ptr(secretValue),
+		}, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		gl.Logger.Debug("error creating or updating project variable", slog.String("repo name", repoName), slog.String("secret name", secretName))
+		return fmt.Errorf("error creating or updating project variable %s: %w", secretName, err)
+	}
+
+	return nil
+}
+
+// CreatePullRequest creates a merge request in the specified project.
+func (gl *GitLab) CreatePullRequest(ctx context.Context, owner, repoName, title, body, head, base string) (*gitlab.MergeRequest, error) {
+	gl.Logger.Debug("creating merge request", slog.String("repo name", repoName), slog.String("title", title), slog.String("head", head), slog.String("base", base))
+	project, err := gl.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	mr, _, err := gl.Client.MergeRequests.CreateMergeRequest(project.ID, &gitlab.CreateMergeRequestOptions{
+		Title:        gitlab.Ptr(title),
+		Description:  gitlab.Ptr(body),
+		SourceBranch: gitlab.Ptr(head),
+		TargetBranch: gitlab.Ptr(base),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		gl.Logger.Debug("error creating merge request", slog.String("repo name", repoName), slog.String("title", title), slog.String("head", head), slog.String("base", base))
+		return nil, fmt.Errorf("error creating merge request: %w", err)
+	}
+
+	return mr, nil
+}
+
+// CreateRepositoryRuleset creates a branch-protection-based approximation of
+// a ruleset for the specified project, protecting the default branch with
+// the given approval requirements.
+func (gl *GitLab) CreateRepositoryRuleset(ctx context.Context, owner, repoName, rulesetName string, requiredApprovals int) error {
+	gl.Logger.Debug("creating branch protection rule", slog.String("repo name", repoName))
+	project, err := gl.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = gl.Client.ProtectedBranches.ProtectRepositoryBranches(project.ID, &gitlab.ProtectRepositoryBranchesOptions{
+		Name: gitlab.Ptr(project.DefaultBranch),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		gl.Logger.Debug("error protecting default branch", slog.String("repo name", repoName))
+		return fmt.Errorf("error protecting default branch: %w", err)
+	}
+
+	if requiredApprovals > 0 {
+		_, _, err = gl.Client.Projects.ChangeApprovalConfiguration(project.ID, &gitlab.ChangeApprovalConfigurationOptions{
+			ApprovalsBeforeMerge: gitlab.Ptr(requiredApprovals),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("error setting required approvals: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package forge
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/angelvargass/go-common/forge/bitbucket"
+)
+
+// bitbucketProvider adapts bitbucket.Bitbucket's native go-bitbucket-v1-typed
+// methods to the forge-agnostic Provider interface.
+type bitbucketProvider struct {
+	client *bitbucket.Bitbucket
+}
+
+func newBitbucketProvider(logger *slog.Logger, token, baseURL string) (Provider, error) {
+	return &bitbucketProvider{client: bitbucket.New(logger, token, baseURL)}, nil
+}
+
+func (p *bitbucketProvider) GetRepository(ctx context.Context, owner, name string) (*Repository, error) {
+	repo, err := p.client.GetRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := VisibilityPrivate
+	if repo.Public {
+		visibility = VisibilityPublic
+	}
+
+	return &Repository{
+		Owner:         owner,
+		Name:          repo.Name,
+		FullName:      owner + "/" + repo.Name,
+		DefaultBranch: "",
+		Visibility:    visibility,
+	}, nil
+}
+
+func (p *bitbucketProvider) CreateRepository(ctx context.Context, organization, name string, opts RepoOptions) (*Repository, error) {
+	repo, err := p.client.CreateRepository(ctx, organization, name, opts.Visibility == VisibilityPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		Owner:      organization,
+		Name:       repo.Name,
+		FullName:   organization + "/" + repo.Name,
+		Visibility: opts.Visibility,
+	}, nil
+}
+
+func (p *bitbucketProvider) CreateBranch(ctx context.Context, owner, repoName, branchName string) (*Reference, error) {
+	if err := p.client.CreateBranch(ctx, owner, repoName, branchName); err != nil {
+		return nil, err
+	}
+	return &Reference{Name: branchName}, nil
+}
+
+func (p *bitbucketProvider) CreateOrUpdateFile(ctx context.Context, owner, repoName, branch, commitMessage, filePath, replacingFileSHA string, fileContent []byte) (*Reference, error) {
+	if err := p.client.CreateOrUpdateFile(ctx, owner, repoName, branch, commitMessage, filePath, replacingFileSHA, fileContent); err != nil {
+		return nil, err
+	}
+	return &Reference{Name: branch}, nil
+}
+
+func (p *bitbucketProvider) CreatePullRequest(ctx context.Context, owner, repoName, title, body, head, base string) (*PullRequest, error) {
+	pr, err := p.client.CreatePullRequest(ctx, owner, repoName, title, body, head, base)
+	if err != nil {
+		return nil, err
+	}
+	return &PullRequest{
+		Number: pr.ID,
+		Title:  pr.Title,
+		Body:   pr.Description,
+		Head:   head,
+		Base:   base,
+	}, nil
+}
+
+func (p *bitbucketProvider) ReplaceTopics(ctx context.Context, owner, repoName string, topics []string) ([]string, error) {
+	return p.client.ReplaceTopics(ctx, owner, repoName, topics)
+}
+
+func (p *bitbucketProvider) CreateOrUpdateRepositorySecret(ctx context.Context, owner, repoName, secretName, secretValue string) error {
+	return p.client.CreateOrUpdateRepositorySecret(ctx, owner, repoName, secretName, secretValue)
+}
+
+func (p *bitbucketProvider) CreateRepositoryRuleset(ctx context.Context, owner, repoName, rulesetName string, rules RulesetRules) error {
+	return p.client.CreateRepositoryRuleset(ctx, owner, repoName, rulesetName)
+}
+
+func (p *bitbucketProvider) GetRepositoryContent(ctx context.Context, owner, repoName, path, ref string) ([]byte, []string, error) {
+	content, err := p.client.GetRepositoryContent(ctx, owner, repoName, path, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, nil, nil
+}
@@ -0,0 +1,165 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ghapi "github.com/google/go-github/v73/github"
+
+	"github.com/angelvargass/go-common/forge/github"
+)
+
+// githubProvider adapts github.Github's native go-github-typed methods to
+// the forge-agnostic Provider interface.
+type githubProvider struct {
+	client *github.Github
+}
+
+func newGithubProvider(logger *slog.Logger, token, baseURL string) (Provider, error) {
+	if baseURL == "" {
+		return &githubProvider{client: github.New(logger, token)}, nil
+	}
+
+	client, err := github.NewWithBaseURL(logger, token, baseURL, baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("error creating enterprise github client: %w", err)
+	}
+
+	return &githubProvider{client: client}, nil
+}
+
+func (p *githubProvider) GetRepository(ctx context.Context, owner, name string) (*Repository, error) {
+	repo, err := p.client.GetRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+	return toRepository(repo), nil
+}
+
+func (p *githubProvider) CreateRepository(ctx context.Context, organization, name string, opts RepoOptions) (*Repository, error) {
+	repo, err := p.client.CreateRepository(ctx, organization, name, github.RepoOptions{
+		Visibility: github.Visibility(opts.Visibility),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toRepository(repo), nil
+}
+
+func (p *githubProvider) CreateBranch(ctx context.Context, owner, repoName, branchName string) (*Reference, error) {
+	ref, err := p.client.CreateBranch(ctx, owner, repoName, branchName)
+	if err != nil {
+		return nil, err
+	}
+	return toReference(ref), nil
+}
+
+func (p *githubProvider) CreateOrUpdateFile(ctx context.Context, owner, repoName, branch, commitMessage, filePath, replacingFileSHA string, fileContent []byte) (*Reference, error) {
+	content, err := p.client.CreateOrUpdateFile(ctx, owner, repoName, branch, commitMessage, filePath, replacingFileSHA, fileContent)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil || content.Commit.SHA == nil {
+		return &Reference{Name: branch}, nil
+	}
+	return &Reference{Name: branch, SHA: *content.Commit.SHA}, nil
+}
+
+func (p *githubProvider) CreatePullRequest(ctx context.Context, owner, repoName, title, body, head, base string) (*PullRequest, error) {
+	pr, err := p.client.CreatePullRequest(ctx, owner, repoName, title, body, head, base)
+	if err != nil {
+		return nil, err
+	}
+	return toPullRequest(pr), nil
+}
+
+func (p *githubProvider) ReplaceTopics(ctx context.Context, owner, repoName string, topics []string) ([]string, error) {
+	return p.client.ReplaceTopics(ctx, owner, repoName, topics)
+}
+
+func (p *githubProvider) CreateOrUpdateRepositorySecret(ctx context.Context, owner, repoName, secretName, secretValue string) error {
+	return p.client.CreateOrUpdateRepositorySecret(ctx, owner, repoName, secretName, secretValue)
+}
+
+func (p *githubProvider) CreateRepositoryRuleset(ctx context.Context, owner, repoName, rulesetName string, rules RulesetRules) error {
+	_, err := p.client.CreateRepositoryRuleset(ctx, owner, repoName, rulesetName, toGithubRulesetRules(rules))
+	return err
+}
+
+func (p *githubProvider) GetRepositoryContent(ctx context.Context, owner, repoName, path, ref string) ([]byte, []string, error) {
+	file, dir, err := p.client.GetRepositoryContent(ctx, owner, repoName, path, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if file != nil {
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, nil, err
+		}
+		return []byte(content), nil, nil
+	}
+
+	names := make([]string, 0, len(dir))
+	for _, entry := range dir {
+		names = append(names, entry.GetName())
+	}
+	return nil, names, nil
+}
+
+func toRepository(repo *ghapi.Repository) *Repository {
+	visibility := VisibilityPublic
+	if repo.GetPrivate() {
+		visibility = VisibilityPrivate
+	}
+	if repo.GetVisibility() == "internal" {
+		visibility = VisibilityInternal
+	}
+
+	return &Repository{
+		Owner:         repo.GetOwner().GetLogin(),
+		Name:          repo.GetName(),
+		FullName:      repo.GetFullName(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		Visibility:    visibility,
+		HTMLURL:       repo.GetHTMLURL(),
+	}
+}
+
+func toReference(ref *ghapi.Reference) *Reference {
+	return &Reference{
+		Name: ref.GetRef(),
+		SHA:  ref.GetObject().GetSHA(),
+	}
+}
+
+func toPullRequest(pr *ghapi.PullRequest) *PullRequest {
+	return &PullRequest{
+		Number:  pr.GetNumber(),
+		Title:   pr.GetTitle(),
+		Body:    pr.GetBody(),
+		Head:    pr.GetHead().GetRef(),
+		Base:    pr.GetBase().GetRef(),
+		HTMLURL: pr.GetHTMLURL(),
+	}
+}
+
+func toGithubRulesetRules(rules RulesetRules) *ghapi.RepositoryRulesetRules {
+	out := &ghapi.RepositoryRulesetRules{}
+	if rules.RequirePullRequest {
+		out.PullRequest = &ghapi.PullRequestRuleParameters{
+			RequiredApprovingReviewCount: rules.RequiredApprovingReviewCount,
+		}
+	}
+	if len(rules.RequiredStatusChecks) > 0 {
+		checks := make([]*ghapi.RuleStatusCheck, 0, len(rules.RequiredStatusChecks))
+		for _, check := range rules.RequiredStatusChecks {
+			checks = append(checks, &ghapi.RuleStatusCheck{Context: check})
+		}
+		out.RequiredStatusChecks = &ghapi.RequiredStatusChecksRuleParameters{
+			RequiredStatusChecks: checks,
+		}
+	}
+	return out
+}
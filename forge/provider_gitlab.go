@@ -0,0 +1,122 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	glapi "github.com/xanzy/go-gitlab"
+
+	"github.com/angelvargass/go-common/forge/gitlab"
+)
+
+// gitlabProvider adapts gitlab.GitLab's native go-gitlab-typed methods to
+// the forge-agnostic Provider interface.
+type gitlabProvider struct {
+	client *gitlab.GitLab
+}
+
+func newGitlabProvider(logger *slog.Logger, token, baseURL string) (Provider, error) {
+	client, err := gitlab.New(logger, token, baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("error creating gitlab client: %w", err)
+	}
+	return &gitlabProvider{client: client}, nil
+}
+
+func (p *gitlabProvider) GetRepository(ctx context.Context, owner, name string) (*Repository, error) {
+	project, err := p.client.GetRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+	return toGitlabRepository(project), nil
+}
+
+func (p *gitlabProvider) CreateRepository(ctx context.Context, organization, name string, opts RepoOptions) (*Repository, error) {
+	project, err := p.client.CreateRepository(ctx, organization, name, gitlab.RepoOptions{
+		Visibility: gitlab.Visibility(opts.Visibility),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toGitlabRepository(project), nil
+}
+
+func (p *gitlabProvider) CreateBranch(ctx context.Context, owner, repoName, branchName string) (*Reference, error) {
+	branch, err := p.client.CreateBranch(ctx, owner, repoName, branchName)
+	if err != nil {
+		return nil, err
+	}
+	return &Reference{Name: branch.Name, SHA: branch.Commit.ID}, nil
+}
+
+func (p *gitlabProvider) CreateOrUpdateFile(ctx context.Context, owner, repoName, branch, commitMessage, filePath, replacingFileSHA string, fileContent []byte) (*Reference, error) {
+	file, err := p.client.CreateOrUpdateFile(ctx, owner, repoName, branch, commitMessage, filePath, fileContent)
+	if err != nil {
+		return nil, err
+	}
+	return &Reference{Name: file.Branch}, nil
+}
+
+func (p *gitlabProvider) CreatePullRequest(ctx context.Context, owner, repoName, title, body, head, base string) (*PullRequest, error) {
+	mr, err := p.client.CreatePullRequest(ctx, owner, repoName, title, body, head, base)
+	if err != nil {
+		return nil, err
+	}
+	return &PullRequest{
+		Number:  mr.IID,
+		Title:   mr.Title,
+		Body:    mr.Description,
+		Head:    mr.SourceBranch,
+		Base:    mr.TargetBranch,
+		HTMLURL: mr.WebURL,
+	}, nil
+}
+
+func (p *gitlabProvider) ReplaceTopics(ctx context.Context, owner, repoName string, topics []string) ([]string, error) {
+	return p.client.ReplaceTopics(ctx, owner, repoName, topics)
+}
+
+func (p *gitlabProvider) CreateOrUpdateRepositorySecret(ctx context.Context, owner, repoName, secretName, secretValue string) error {
+	return p.client.CreateOrUpdateRepositorySecret(ctx, owner, repoName, secretName, secretValue)
+}
+
+func (p *gitlabProvider) CreateRepositoryRuleset(ctx context.Context, owner, repoName, rulesetName string, rules RulesetRules) error {
+	return p.client.CreateRepositoryRuleset(ctx, owner, repoName, rulesetName, rules.RequiredApprovingReviewCount)
+}
+
+func (p *gitlabProvider) GetRepositoryContent(ctx context.Context, owner, repoName, path, ref string) ([]byte, []string, error) {
+	content, tree, err := p.client.GetRepositoryContent(ctx, owner, repoName, path, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if content != nil {
+		return content, nil, nil
+	}
+
+	names := make([]string, 0, len(tree))
+	for _, node := range tree {
+		names = append(names, node.Name)
+	}
+	return nil, names, nil
+}
+
+func toGitlabRepository(project *glapi.Project) *Repository {
+	visibility := VisibilityPublic
+	switch project.Visibility {
+	case glapi.PrivateVisibility:
+		visibility = VisibilityPrivate
+	case glapi.InternalVisibility:
+		visibility = VisibilityInternal
+	}
+
+	return &Repository{
+		Owner:         project.Namespace.Path,
+		Name:          project.Name,
+		FullName:      project.PathWithNamespace,
+		DefaultBranch: project.DefaultBranch,
+		Visibility:    visibility,
+		HTMLURL:       project.WebURL,
+	}
+}